@@ -0,0 +1,91 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package codepipeline provides a client to make API requests to AWS CodePipeline.
+package codepipeline
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/codepipeline"
+	"github.com/aws/aws-sdk-go/service/codepipeline/codepipelineiface"
+)
+
+// Custom action categories, mirroring the categories CodePipeline itself recognizes for a stage.
+const (
+	CustomActionCategorySource   = "Source"
+	CustomActionCategoryBuild    = "Build"
+	CustomActionCategoryTest     = "Test"
+	CustomActionCategoryDeploy   = "Deploy"
+	CustomActionCategoryInvoke   = "Invoke"
+	CustomActionCategoryApproval = "Approval"
+)
+
+// CustomActionTypeInput describes a user-defined CodePipeline action provider (for example an
+// on-prem Jenkins job or a partner-provided scanner) that a pipeline stage can invoke.
+type CustomActionTypeInput struct {
+	Category                string
+	Provider                string
+	Version                 string
+	ConfigurationProperties map[string]string
+	InputArtifactCount      int
+	OutputArtifactCount     int
+}
+
+// CodePipeline wraps an AWS CodePipeline client.
+type CodePipeline struct {
+	codePipeline codepipelineiface.CodePipelineAPI
+}
+
+// New returns a CodePipeline configured against the input session.
+func New(s *session.Session) *CodePipeline {
+	return &CodePipeline{
+		codePipeline: codepipeline.New(s),
+	}
+}
+
+// CreateCustomActionType registers a custom action type so a pipeline stage can reference it.
+func (c *CodePipeline) CreateCustomActionType(in *CustomActionTypeInput) error {
+	configProps := make([]*codepipeline.ActionConfigurationProperty, 0, len(in.ConfigurationProperties))
+	for name := range in.ConfigurationProperties {
+		configProps = append(configProps, &codepipeline.ActionConfigurationProperty{
+			Name:     aws.String(name),
+			Key:      aws.Bool(false),
+			Required: aws.Bool(true),
+			Secret:   aws.Bool(false),
+		})
+	}
+	_, err := c.codePipeline.CreateCustomActionType(&codepipeline.CreateCustomActionTypeInput{
+		Category:                aws.String(in.Category),
+		Provider:                aws.String(in.Provider),
+		Version:                 aws.String(in.Version),
+		ConfigurationProperties: configProps,
+		InputArtifactDetails: &codepipeline.ArtifactDetails{
+			MinimumCount: aws.Int64(int64(in.InputArtifactCount)),
+			MaximumCount: aws.Int64(int64(in.InputArtifactCount)),
+		},
+		OutputArtifactDetails: &codepipeline.ArtifactDetails{
+			MinimumCount: aws.Int64(int64(in.OutputArtifactCount)),
+			MaximumCount: aws.Int64(int64(in.OutputArtifactCount)),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("create custom action type %s/%s/%s: %w", in.Category, in.Provider, in.Version, err)
+	}
+	return nil
+}
+
+// DeleteCustomActionType tears down a previously registered custom action type.
+func (c *CodePipeline) DeleteCustomActionType(category, provider, version string) error {
+	_, err := c.codePipeline.DeleteCustomActionType(&codepipeline.DeleteCustomActionTypeInput{
+		Category: aws.String(category),
+		Provider: aws.String(provider),
+		Version:  aws.String(version),
+	})
+	if err != nil {
+		return fmt.Errorf("delete custom action type %s/%s/%s: %w", category, provider, version, err)
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package secretsmanager provides a client to make API requests to AWS Secrets Manager.
+package secretsmanager
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+)
+
+const (
+	// secretTagKey and secretTagValue mark every secret copilot creates so that `secret ls`-style
+	// commands and cleanup code can distinguish copilot-owned secrets from unrelated ones.
+	secretTagKey   = "copilot-application"
+	secretTagValue = "true"
+)
+
+// SecretsManager wraps an AWS Secrets Manager client.
+type SecretsManager struct {
+	secretsManager secretsmanageriface.SecretsManagerAPI
+}
+
+// New returns a SecretsManager configured against the input session.
+func New(s *session.Session) *SecretsManager {
+	return &SecretsManager{
+		secretsManager: secretsmanager.New(s),
+	}
+}
+
+// CreateSecret creates a secret using the value given and returns its ARN.
+func (s *SecretsManager) CreateSecret(secretName, secretString string) (string, error) {
+	resp, err := s.secretsManager.CreateSecret(&secretsmanager.CreateSecretInput{
+		Name:         aws.String(secretName),
+		SecretString: aws.String(secretString),
+		Tags: []*secretsmanager.Tag{
+			{
+				Key:   aws.String(secretTagKey),
+				Value: aws.String(secretTagValue),
+			},
+		},
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == secretsmanager.ErrCodeResourceExistsException {
+			return "", &ErrSecretAlreadyExists{
+				secretName: secretName,
+				parentErr:  err,
+			}
+		}
+		return "", fmt.Errorf("create secret %s: %w", secretName, err)
+	}
+	return aws.StringValue(resp.ARN), nil
+}
+
+// UpdateSecret patches an existing secret with a new version of its value and returns the ARN.
+func (s *SecretsManager) UpdateSecret(secretName, secretString string) (string, error) {
+	resp, err := s.secretsManager.UpdateSecret(&secretsmanager.UpdateSecretInput{
+		SecretId:     aws.String(secretName),
+		SecretString: aws.String(secretString),
+	})
+	if err != nil {
+		return "", fmt.Errorf("update secret %s: %w", secretName, err)
+	}
+	return aws.StringValue(resp.ARN), nil
+}
+
+// DeleteSecret removes a secret.
+func (s *SecretsManager) DeleteSecret(secretName string) error {
+	_, err := s.secretsManager.DeleteSecret(&secretsmanager.DeleteSecretInput{
+		SecretId:                   aws.String(secretName),
+		ForceDeleteWithoutRecovery: aws.Bool(true),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == secretsmanager.ErrCodeResourceNotFoundException {
+			return nil
+		}
+		return fmt.Errorf("delete secret %s: %w", secretName, err)
+	}
+	return nil
+}
+
+// DescribeSecret returns whether a secret with the given name already exists.
+func (s *SecretsManager) DescribeSecret(secretName string) (bool, error) {
+	_, err := s.secretsManager.DescribeSecret(&secretsmanager.DescribeSecretInput{
+		SecretId: aws.String(secretName),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == secretsmanager.ErrCodeResourceNotFoundException {
+			return false, nil
+		}
+		return false, fmt.Errorf("describe secret %s: %w", secretName, err)
+	}
+	return true, nil
+}
+
+// RotateSecret enables automatic rotation for a secret using a Lambda function ARN the caller
+// provides, rotating on the given cadence.
+func (s *SecretsManager) RotateSecret(secretName string, rotationLambdaARN string, days int64) error {
+	_, err := s.secretsManager.RotateSecret(&secretsmanager.RotateSecretInput{
+		SecretId:          aws.String(secretName),
+		RotationLambdaARN: aws.String(rotationLambdaARN),
+		RotationRules: &secretsmanager.RotationRulesType{
+			AutomaticallyAfterDays: aws.Int64(days),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("rotate secret %s: %w", secretName, err)
+	}
+	return nil
+}
+
+// ErrSecretAlreadyExists occurs when a secret with the same name already exists.
+type ErrSecretAlreadyExists struct {
+	secretName string
+	parentErr  error
+}
+
+func (e *ErrSecretAlreadyExists) Error() string {
+	return fmt.Sprintf("secret %s already exists", e.secretName)
+}
+
+// Unwrap returns the parent error.
+func (e *ErrSecretAlreadyExists) Unwrap() error {
+	return e.parentErr
+}
@@ -0,0 +1,52 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+// defaultCatalog is the process-wide commandCatalog. Every build*Cmd function for a
+// cataloguedCommand registers its metadata here as the command tree is constructed, so `copilot
+// commands` stays in sync with the commands actually wired up without a separate list to
+// maintain by hand.
+var defaultCatalog = newCatalog()
+
+// catalog is the concrete, in-memory implementation of commandCatalog and catalogDescriber.
+type catalog struct {
+	order      []string
+	byCategory map[string][]CommandMetadata
+}
+
+func newCatalog() *catalog {
+	return &catalog{
+		byCategory: make(map[string][]CommandMetadata),
+	}
+}
+
+// Register records a command's catalog entry under its category.
+func (c *catalog) Register(meta CommandMetadata) {
+	if _, seen := c.byCategory[meta.Category]; !seen {
+		c.order = append(c.order, meta.Category)
+	}
+	c.byCategory[meta.Category] = append(c.byCategory[meta.Category], meta)
+}
+
+// Categories returns every category that has at least one registered command, in the order the
+// first command in each category was registered.
+func (c *catalog) Categories() []string {
+	categories := make([]string, len(c.order))
+	copy(categories, c.order)
+	return categories
+}
+
+// CommandsByCategory returns the commands registered under category, in registration order.
+func (c *catalog) CommandsByCategory(category string) []CommandMetadata {
+	return c.byCategory[category]
+}
+
+// Describe renders the catalog into its machine-readable form for `copilot commands --format=json`.
+func (c *catalog) Describe() (*CatalogDescription, error) {
+	desc := &CatalogDescription{Categories: c.Categories()}
+	for _, category := range desc.Categories {
+		desc.Commands = append(desc.Commands, c.CommandsByCategory(category)...)
+	}
+	return desc, nil
+}
@@ -0,0 +1,35 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCatalog_RegisterAndDescribe(t *testing.T) {
+	c := newCatalog()
+	c.Register(CommandMetadata{ID: "secret-init", Category: "Secrets"})
+	c.Register(CommandMetadata{ID: "pipeline-init", Category: "Pipelines & CI/CD"})
+	c.Register(CommandMetadata{ID: "pipeline-delete", Category: "Pipelines & CI/CD"})
+
+	require.Equal(t, []string{"Secrets", "Pipelines & CI/CD"}, c.Categories())
+	require.Equal(t, []CommandMetadata{{ID: "secret-init", Category: "Secrets"}}, c.CommandsByCategory("Secrets"))
+	require.Equal(t, []CommandMetadata{
+		{ID: "pipeline-init", Category: "Pipelines & CI/CD"},
+		{ID: "pipeline-delete", Category: "Pipelines & CI/CD"},
+	}, c.CommandsByCategory("Pipelines & CI/CD"))
+	require.Empty(t, c.CommandsByCategory("Cleanup"))
+
+	desc, err := c.Describe()
+	require.NoError(t, err)
+	require.Equal(t, []string{"Secrets", "Pipelines & CI/CD"}, desc.Categories)
+	require.Len(t, desc.Commands, 3)
+}
+
+func TestPrintCatalog_InvalidFormat(t *testing.T) {
+	err := printCatalog(newCatalog(), "yaml")
+	require.EqualError(t, err, `invalid --format: "yaml", must be one of: tree, json, markdown`)
+}
@@ -0,0 +1,97 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+const commandsFormatFlag = "format"
+
+// buildCommandsCmd lists every command registered in defaultCatalog, grouped by category. It's
+// the human- and machine-readable front end for commandCatalog/catalogDescriber.
+func buildCommandsCmd() *cobra.Command {
+	var format string
+	cmd := &cobra.Command{
+		Use:   "commands",
+		Short: "List Copilot's commands grouped by category.",
+		Example: `
+  Print every command grouped under its category.
+  /code $ copilot commands
+
+  Print the command catalog as JSON for IDE and agent integrations.
+  /code $ copilot commands --format=json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return printCatalog(defaultCatalog, format)
+		},
+	}
+	cmd.Flags().StringVar(&format, commandsFormatFlag, "tree", "Output format: tree, json, or markdown.")
+	return cmd
+}
+
+func printCatalog(desc catalogDescriber, format string) error {
+	switch format {
+	case "", "tree":
+		return printCatalogTree(desc)
+	case "json":
+		return printCatalogJSON(desc)
+	case "markdown":
+		return printCatalogMarkdown(desc)
+	default:
+		return fmt.Errorf("invalid --%s: %q, must be one of: tree, json, markdown", commandsFormatFlag, format)
+	}
+}
+
+func printCatalogTree(desc catalogDescriber) error {
+	catalog, err := desc.Describe()
+	if err != nil {
+		return fmt.Errorf("describe command catalog: %w", err)
+	}
+	byCategory := make(map[string][]CommandMetadata)
+	for _, meta := range catalog.Commands {
+		byCategory[meta.Category] = append(byCategory[meta.Category], meta)
+	}
+	for _, category := range catalog.Categories {
+		fmt.Println(category)
+		for _, meta := range byCategory[category] {
+			fmt.Printf("  %s\n", meta.ID)
+		}
+	}
+	return nil
+}
+
+func printCatalogJSON(desc catalogDescriber) error {
+	catalog, err := desc.Describe()
+	if err != nil {
+		return fmt.Errorf("describe command catalog: %w", err)
+	}
+	out, err := json.MarshalIndent(catalog, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal command catalog: %w", err)
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
+func printCatalogMarkdown(desc catalogDescriber) error {
+	catalog, err := desc.Describe()
+	if err != nil {
+		return fmt.Errorf("describe command catalog: %w", err)
+	}
+	byCategory := make(map[string][]CommandMetadata)
+	for _, meta := range catalog.Commands {
+		byCategory[meta.Category] = append(byCategory[meta.Category], meta)
+	}
+	for _, category := range catalog.Categories {
+		fmt.Printf("## %s\n\n", category)
+		for _, meta := range byCategory[category] {
+			fmt.Printf("- `%s`\n", meta.ID)
+		}
+		fmt.Println()
+	}
+	return nil
+}
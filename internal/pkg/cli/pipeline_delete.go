@@ -0,0 +1,181 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	pipelineDeleteAppFlag  = "app"
+	pipelineDeleteNameFlag = "name"
+	pipelineDeleteYesFlag  = "yes"
+)
+
+type pipelineDeleteVars struct {
+	appName      string
+	pipelineName string
+	skipConfirm  bool
+}
+
+// pipelineDeleteOpts holds the options and dependencies needed to tear down a pipeline, including
+// any custom CodePipeline action types it registered.
+type pipelineDeleteOpts struct {
+	pipelineDeleteVars
+
+	deployer       pipelineDeployer
+	manifestReader wsPipelineManifestReader
+}
+
+func newPipelineDeleteOpts(vars pipelineDeleteVars) (*pipelineDeleteOpts, error) {
+	sess, err := session.NewSession()
+	if err != nil {
+		return nil, fmt.Errorf("create session: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("create workspace: %w", err)
+	}
+	return &pipelineDeleteOpts{
+		pipelineDeleteVars: vars,
+		deployer:           cloudformation.New(sess),
+		manifestReader:     ws,
+	}, nil
+}
+
+// Validate returns an error if the flag values are invalid.
+func (o *pipelineDeleteOpts) Validate() error {
+	if o.appName == "" {
+		return fmt.Errorf("application name must not be empty")
+	}
+	if o.pipelineName == "" {
+		return fmt.Errorf("pipeline name must not be empty")
+	}
+	return nil
+}
+
+// Ask is a no-op: the deletion confirmation prompt (skipped with --yes) runs in the command's RunE
+// before Ask is called.
+func (o *pipelineDeleteOpts) Ask() error {
+	return nil
+}
+
+// customActionRef identifies a custom action type a pipeline manifest's stage registered, so
+// pipeline delete can tear it down alongside the pipeline stack.
+type customActionRef struct {
+	Category string `yaml:"category"`
+	Provider string `yaml:"provider"`
+	Version  string `yaml:"version"`
+}
+
+// customActionRefs parses the pipeline manifest's stage list for every custom_action it
+// registered. A pipeline with no custom action stages returns an empty slice.
+func (o *pipelineDeleteOpts) customActionRefs() ([]customActionRef, error) {
+	raw, err := o.manifestReader.ReadPipelineManifest()
+	if err != nil {
+		return nil, fmt.Errorf("read pipeline manifest for %s: %w", o.pipelineName, err)
+	}
+	var doc struct {
+		Stages []struct {
+			CustomAction *customActionRef `yaml:"custom_action"`
+		} `yaml:"stages"`
+	}
+	if err := yaml.Unmarshal(raw, &doc); err != nil {
+		return nil, fmt.Errorf("parse pipeline manifest for %s: %w", o.pipelineName, err)
+	}
+	var refs []customActionRef
+	for _, stage := range doc.Stages {
+		if stage.CustomAction != nil {
+			refs = append(refs, *stage.CustomAction)
+		}
+	}
+	return refs, nil
+}
+
+// Execute tears down every custom action type the pipeline manifest registered, then deletes the
+// pipeline itself. Both go through the same pipelineDeployer used to create the stack, so the
+// action type's lifecycle stays tied to it instead of a separately-injected CodePipeline client.
+func (o *pipelineDeleteOpts) Execute() error {
+	refs, err := o.customActionRefs()
+	if err != nil {
+		return err
+	}
+	for _, ref := range refs {
+		if err := o.deployer.DeleteCustomActionType(ref.Category, ref.Provider, ref.Version); err != nil {
+			return fmt.Errorf("delete custom action type %s/%s/%s: %w", ref.Category, ref.Provider, ref.Version, err)
+		}
+	}
+	if err := o.deployer.DeletePipeline(o.pipelineName); err != nil {
+		return fmt.Errorf("delete pipeline %s: %w", o.pipelineName, err)
+	}
+	return nil
+}
+
+// RecommendedActions returns follow-up suggestions for the user.
+func (o *pipelineDeleteOpts) RecommendedActions() []string {
+	return nil
+}
+
+// Metadata returns the command's catalog entry.
+func (o *pipelineDeleteOpts) Metadata() CommandMetadata {
+	return CommandMetadata{
+		ID:       "pipeline-delete",
+		Category: "Cleanup",
+	}
+}
+
+func buildPipelineDeleteCmd() *cobra.Command {
+	defaultCatalog.Register((&pipelineDeleteOpts{}).Metadata())
+
+	vars := pipelineDeleteVars{}
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete a deployed pipeline.",
+		Example: `
+  Delete a pipeline, skipping confirmation.
+  /code $ copilot pipeline delete --app my-app --name my-pipeline --yes`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := newPipelineDeleteOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if !opts.skipConfirm {
+				confirmed, err := prompt.New().Confirm(
+					fmt.Sprintf("Are you sure you want to delete pipeline %s?", opts.pipelineName),
+					"This deletes the pipeline's CodePipeline stack and any custom action types it registered.",
+				)
+				if err != nil {
+					return fmt.Errorf("confirm pipeline deletion: %w", err)
+				}
+				if !confirmed {
+					return nil
+				}
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			if err := opts.Execute(); err != nil {
+				return err
+			}
+			for _, action := range opts.RecommendedActions() {
+				fmt.Println(action)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&vars.appName, pipelineDeleteAppFlag, "", "Name of the application.")
+	cmd.Flags().StringVar(&vars.pipelineName, pipelineDeleteNameFlag, "", "Name of the pipeline.")
+	cmd.Flags().BoolVar(&vars.skipConfirm, pipelineDeleteYesFlag, false, "Skip confirmation prompt.")
+	return cmd
+}
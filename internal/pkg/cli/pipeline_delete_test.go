@@ -0,0 +1,125 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/stretchr/testify/require"
+)
+
+type fakePipelineManifestReader struct {
+	manifest []byte
+	err      error
+}
+
+func (f *fakePipelineManifestReader) ReadPipelineManifest() ([]byte, error) {
+	return f.manifest, f.err
+}
+
+type fakePipelineDeployer struct {
+	deletedActionTypes []customActionRef
+	deletePipelineErr  error
+	deleteActionErr    error
+	deletedPipeline    string
+}
+
+func (f *fakePipelineDeployer) CreatePipeline(env *deploy.CreatePipelineInput) error { return nil }
+func (f *fakePipelineDeployer) UpdatePipeline(env *deploy.CreatePipelineInput) error { return nil }
+func (f *fakePipelineDeployer) PipelineExists(env *deploy.CreatePipelineInput) (bool, error) {
+	return false, nil
+}
+func (f *fakePipelineDeployer) DeletePipeline(pipelineName string) error {
+	f.deletedPipeline = pipelineName
+	return f.deletePipelineErr
+}
+func (f *fakePipelineDeployer) AddPipelineResourcesToApp(app *config.Application, region string) error {
+	return nil
+}
+func (f *fakePipelineDeployer) GetAppResourcesByRegion(app *config.Application, region string) (*stack.AppRegionalResources, error) {
+	return nil, nil
+}
+func (f *fakePipelineDeployer) GetRegionalAppResources(app *config.Application) ([]*stack.AppRegionalResources, error) {
+	return nil, nil
+}
+func (f *fakePipelineDeployer) CreateCustomActionType(in *codepipeline.CustomActionTypeInput) error {
+	return nil
+}
+func (f *fakePipelineDeployer) DeleteCustomActionType(category, provider, version string) error {
+	f.deletedActionTypes = append(f.deletedActionTypes, customActionRef{Category: category, Provider: provider, Version: version})
+	return f.deleteActionErr
+}
+
+func TestPipelineDeleteOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		vars    pipelineDeleteVars
+		wantErr string
+	}{
+		"missing app name":      {vars: pipelineDeleteVars{pipelineName: "p"}, wantErr: "application name must not be empty"},
+		"missing pipeline name": {vars: pipelineDeleteVars{appName: "a"}, wantErr: "pipeline name must not be empty"},
+		"valid":                 {vars: pipelineDeleteVars{appName: "a", pipelineName: "p"}},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			o := &pipelineDeleteOpts{pipelineDeleteVars: tc.vars}
+			err := o.Validate()
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestPipelineDeleteOpts_Execute(t *testing.T) {
+	t.Run("tears down every custom action type before deleting the pipeline", func(t *testing.T) {
+		deployer := &fakePipelineDeployer{}
+		o := &pipelineDeleteOpts{
+			pipelineDeleteVars: pipelineDeleteVars{pipelineName: "my-pipeline"},
+			deployer:           deployer,
+			manifestReader: &fakePipelineManifestReader{manifest: []byte(`
+stages:
+  - name: build
+  - custom_action:
+      category: Deploy
+      provider: JenkinsJob
+      version: "1"
+`)},
+		}
+
+		require.NoError(t, o.Execute())
+		require.Equal(t, "my-pipeline", deployer.deletedPipeline)
+		require.Equal(t, []customActionRef{{Category: "Deploy", Provider: "JenkinsJob", Version: "1"}}, deployer.deletedActionTypes)
+	})
+
+	t.Run("is a no-op custom action teardown when there are none", func(t *testing.T) {
+		deployer := &fakePipelineDeployer{}
+		o := &pipelineDeleteOpts{
+			pipelineDeleteVars: pipelineDeleteVars{pipelineName: "my-pipeline"},
+			deployer:           deployer,
+			manifestReader:     &fakePipelineManifestReader{manifest: []byte("stages:\n  - name: build\n")},
+		}
+
+		require.NoError(t, o.Execute())
+		require.Equal(t, "my-pipeline", deployer.deletedPipeline)
+		require.Empty(t, deployer.deletedActionTypes)
+	})
+
+	t.Run("propagates a manifest read error", func(t *testing.T) {
+		deployer := &fakePipelineDeployer{}
+		o := &pipelineDeleteOpts{
+			deployer:       deployer,
+			manifestReader: &fakePipelineManifestReader{err: errors.New("not found")},
+		}
+
+		require.Error(t, o.Execute())
+		require.Empty(t, deployer.deletedPipeline)
+	})
+}
@@ -0,0 +1,257 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	pipelineInitAppFlag = "app"
+
+	pipelineActionTypeBuiltIn = "Built-in provider (GitHub, CodeCommit, CodeBuild, CloudFormation)"
+	pipelineActionTypeCustom  = "Custom action type"
+)
+
+type pipelineInitVars struct {
+	appName string
+}
+
+// pipelineInitOpts holds the options and dependencies needed to scaffold a pipeline manifest,
+// including an optional custom CodePipeline action type stage.
+type pipelineInitOpts struct {
+	pipelineInitVars
+
+	sel       configSelector
+	prompt    pipelineActionTypePrompter
+	ws        pipelineManifestReadWriter
+	registrar customActionRegistrar
+
+	customAction *manifest.CustomAction
+}
+
+func newPipelineInitOpts(vars pipelineInitVars) (*pipelineInitOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to config store: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("create workspace: %w", err)
+	}
+	sess, err := session.NewSession()
+	if err != nil {
+		return nil, fmt.Errorf("create session: %w", err)
+	}
+	p := prompt.New()
+	return &pipelineInitOpts{
+		pipelineInitVars: vars,
+		sel:              selector.NewConfigSelect(p, store),
+		prompt:           p,
+		ws:               ws,
+		registrar:        codepipeline.New(sess),
+	}, nil
+}
+
+// Validate is a no-op: every flag pipeline init accepts is optional, since a missing --app is
+// filled in by Ask prompting through configSelector instead of being rejected outright.
+func (o *pipelineInitOpts) Validate() error {
+	return nil
+}
+
+// Ask prompts the user for whether a pipeline stage should use a built-in provider or a
+// user-defined custom action type, collecting the extra fields a custom action needs.
+func (o *pipelineInitOpts) Ask() error {
+	if o.appName == "" {
+		app, err := o.sel.Application("Which application does this pipeline belong to?", "")
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+
+	choice, err := o.prompt.SelectOne(
+		"What kind of action should this stage run?",
+		"Built-in providers cover GitHub, CodeCommit, CodeBuild, and CloudFormation. Choose custom to invoke a user-defined CodePipeline action type, such as an on-prem Jenkins job or a partner scanner.",
+		[]string{pipelineActionTypeBuiltIn, pipelineActionTypeCustom},
+	)
+	if err != nil {
+		return fmt.Errorf("select pipeline action type: %w", err)
+	}
+	if choice != pipelineActionTypeCustom {
+		return nil
+	}
+
+	category, err := o.prompt.Get("What category is the custom action? (Source/Build/Test/Deploy/Invoke/Approval)", "", nil)
+	if err != nil {
+		return fmt.Errorf("get custom action category: %w", err)
+	}
+	provider, err := o.prompt.Get("What is the provider name?", "", nil)
+	if err != nil {
+		return fmt.Errorf("get custom action provider: %w", err)
+	}
+	version, err := o.prompt.Get("What version of the action should Copilot register?", "", nil)
+	if err != nil {
+		return fmt.Errorf("get custom action version: %w", err)
+	}
+	o.customAction = &manifest.CustomAction{
+		Category: category,
+		Provider: provider,
+		Version:  version,
+	}
+	return nil
+}
+
+// Execute registers the custom action type (if one was chosen) and appends a custom_action stage
+// referencing it to the pipeline manifest.
+func (o *pipelineInitOpts) Execute() error {
+	if !o.customAction.IsCustom() {
+		return nil
+	}
+	if err := o.registrar.CreateCustomActionType(customActionTypeInput(o.customAction)); err != nil {
+		return fmt.Errorf("register custom action type: %w", err)
+	}
+	existing, err := o.ws.ReadPipelineManifest()
+	if err != nil {
+		return fmt.Errorf("read pipeline manifest: %w", err)
+	}
+	if _, err := o.ws.WritePipelineManifest(&pipelineManifest{existing: existing, CustomAction: o.customAction}); err != nil {
+		return fmt.Errorf("write pipeline manifest: %w", err)
+	}
+	return nil
+}
+
+// customActionTypeInput translates the manifest's custom action section into the CFN/API shape
+// customActionRegistrar expects.
+func customActionTypeInput(a *manifest.CustomAction) *codepipeline.CustomActionTypeInput {
+	return &codepipeline.CustomActionTypeInput{
+		Category:                a.Category,
+		Provider:                a.Provider,
+		Version:                 a.Version,
+		ConfigurationProperties: a.Configuration,
+		InputArtifactCount:      a.InputArtifacts,
+		OutputArtifactCount:     a.OutputArtifacts,
+	}
+}
+
+// pipelineManifest wraps whatever manifest bytes are already on disk and appends a custom_action
+// stage to them; it never discards existing content or other top-level keys. pipelineInitOpts.
+// Execute only constructs one when a custom action was actually chosen.
+type pipelineManifest struct {
+	existing     []byte
+	CustomAction *manifest.CustomAction
+}
+
+// customActionStage is the YAML shape of a single stages[] entry that invokes a custom action.
+type customActionStage struct {
+	CustomAction *manifest.CustomAction `yaml:"custom_action"`
+}
+
+// MarshalBinary parses the existing manifest and appends the custom action stage to its "stages"
+// list, re-marshaling the whole document rather than concatenating raw bytes, so the result is
+// well-formed regardless of what else is already in the manifest or how it's formatted.
+func (m *pipelineManifest) MarshalBinary() ([]byte, error) {
+	if !m.CustomAction.IsCustom() {
+		return m.existing, nil
+	}
+
+	var root yaml.Node
+	if len(m.existing) > 0 {
+		if err := yaml.Unmarshal(m.existing, &root); err != nil {
+			return nil, fmt.Errorf("parse existing pipeline manifest: %w", err)
+		}
+	}
+	if root.Kind == 0 {
+		root.Kind = yaml.DocumentNode
+	}
+	if len(root.Content) == 0 {
+		root.Content = []*yaml.Node{{Kind: yaml.MappingNode}}
+	}
+	doc := root.Content[0]
+
+	var stages *yaml.Node
+	for i := 0; i+1 < len(doc.Content); i += 2 {
+		if doc.Content[i].Value == "stages" {
+			stages = doc.Content[i+1]
+			break
+		}
+	}
+	if stages == nil {
+		doc.Content = append(doc.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Value: "stages"},
+			&yaml.Node{Kind: yaml.SequenceNode},
+		)
+		stages = doc.Content[len(doc.Content)-1]
+	}
+
+	var stageNode yaml.Node
+	if err := stageNode.Encode(customActionStage{CustomAction: m.CustomAction}); err != nil {
+		return nil, fmt.Errorf("encode custom action stage: %w", err)
+	}
+	stages.Content = append(stages.Content, &stageNode)
+
+	return yaml.Marshal(&root)
+}
+
+// RecommendedActions returns follow-up suggestions for the user.
+func (o *pipelineInitOpts) RecommendedActions() []string {
+	if !o.customAction.IsCustom() {
+		return nil
+	}
+	return []string{
+		fmt.Sprintf("Registered custom action type %s/%s/%s and added a stage for it to your pipeline manifest.", o.customAction.Category, o.customAction.Provider, o.customAction.Version),
+	}
+}
+
+// Metadata returns the command's catalog entry.
+func (o *pipelineInitOpts) Metadata() CommandMetadata {
+	return CommandMetadata{
+		ID:       "pipeline-init",
+		Category: "Pipelines & CI/CD",
+	}
+}
+
+func buildPipelineInitCmd() *cobra.Command {
+	defaultCatalog.Register((&pipelineInitOpts{}).Metadata())
+
+	vars := pipelineInitVars{}
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "Scaffold a pipeline manifest for an application.",
+		Example: `
+  Scaffold a pipeline, prompting for a custom CodePipeline action type if one of the stages needs it.
+  /code $ copilot pipeline init --app my-app`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := newPipelineInitOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			if err := opts.Execute(); err != nil {
+				return err
+			}
+			for _, action := range opts.RecommendedActions() {
+				fmt.Println(action)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&vars.appName, pipelineInitAppFlag, "", "Name of the application.")
+	return cmd
+}
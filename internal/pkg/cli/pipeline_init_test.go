@@ -0,0 +1,217 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomAction_IsCustom(t *testing.T) {
+	testCases := map[string]struct {
+		action *manifest.CustomAction
+		want   bool
+	}{
+		"nil action":      {action: nil, want: false},
+		"no provider set": {action: &manifest.CustomAction{Category: "Build"}, want: false},
+		"provider set":    {action: &manifest.CustomAction{Provider: "JenkinsJob"}, want: true},
+		"fully populated": {action: &manifest.CustomAction{Category: "Build", Provider: "JenkinsJob", Version: "1"}, want: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.want, tc.action.IsCustom())
+		})
+	}
+}
+
+type fakeActionTypePrompter struct {
+	selectOneOut string
+	selectOneErr error
+
+	getAnswers []string
+	getErr     error
+	getCalls   int
+}
+
+func (f *fakeActionTypePrompter) SelectOne(prompt, help string, options []string, opts ...prompt.Option) (string, error) {
+	return f.selectOneOut, f.selectOneErr
+}
+
+func (f *fakeActionTypePrompter) Get(prompt, help string, validator prompt.ValidatorFunc, opts ...prompt.Option) (string, error) {
+	if f.getErr != nil {
+		return "", f.getErr
+	}
+	answer := f.getAnswers[f.getCalls]
+	f.getCalls++
+	return answer, nil
+}
+
+type fakePipelineManifestReadWriter struct {
+	existing []byte
+	readErr  error
+
+	written  []byte
+	writeErr error
+}
+
+func (f *fakePipelineManifestReadWriter) ReadPipelineManifest() ([]byte, error) {
+	return f.existing, f.readErr
+}
+
+func (f *fakePipelineManifestReadWriter) WritePipelineBuildspec(marshaler encoding.BinaryMarshaler) (string, error) {
+	return "", nil
+}
+
+func (f *fakePipelineManifestReadWriter) WritePipelineManifest(marshaler encoding.BinaryMarshaler) (string, error) {
+	if f.writeErr != nil {
+		return "", f.writeErr
+	}
+	out, err := marshaler.MarshalBinary()
+	if err != nil {
+		return "", err
+	}
+	f.written = out
+	return "copilot/pipelines/my-pipeline/manifest.yml", nil
+}
+
+type fakeAppSelector struct {
+	appOut string
+	appErr error
+}
+
+func (f *fakeAppSelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+	return f.appOut, f.appErr
+}
+
+func (f *fakeAppSelector) Environment(prompt, help, app string, additionalOpts ...string) (string, error) {
+	return "", nil
+}
+
+func (f *fakeAppSelector) Service(prompt, help, app string) (string, error) {
+	return "", nil
+}
+
+func TestPipelineInitOpts_Validate(t *testing.T) {
+	require.NoError(t, (&pipelineInitOpts{}).Validate())
+}
+
+func TestPipelineInitOpts_Ask(t *testing.T) {
+	t.Run("prompts for the application when --app is missing", func(t *testing.T) {
+		o := &pipelineInitOpts{
+			sel:    &fakeAppSelector{appOut: "my-app"},
+			prompt: &fakeActionTypePrompter{selectOneOut: pipelineActionTypeBuiltIn},
+		}
+		require.NoError(t, o.Ask())
+		require.Equal(t, "my-app", o.appName)
+	})
+
+	t.Run("propagates an application selection error", func(t *testing.T) {
+		o := &pipelineInitOpts{
+			sel: &fakeAppSelector{appErr: errors.New("boom")},
+		}
+		require.Error(t, o.Ask())
+	})
+
+	t.Run("built-in provider needs no follow-up questions", func(t *testing.T) {
+		o := &pipelineInitOpts{
+			pipelineInitVars: pipelineInitVars{appName: "my-app"},
+			prompt:           &fakeActionTypePrompter{selectOneOut: pipelineActionTypeBuiltIn},
+		}
+		require.NoError(t, o.Ask())
+		require.Nil(t, o.customAction)
+	})
+
+	t.Run("custom action type collects category, provider, and version", func(t *testing.T) {
+		o := &pipelineInitOpts{
+			pipelineInitVars: pipelineInitVars{appName: "my-app"},
+			prompt: &fakeActionTypePrompter{
+				selectOneOut: pipelineActionTypeCustom,
+				getAnswers:   []string{"Build", "JenkinsJob", "1"},
+			},
+		}
+		require.NoError(t, o.Ask())
+		require.Equal(t, &manifest.CustomAction{Category: "Build", Provider: "JenkinsJob", Version: "1"}, o.customAction)
+	})
+
+	t.Run("propagates a selection error", func(t *testing.T) {
+		o := &pipelineInitOpts{
+			pipelineInitVars: pipelineInitVars{appName: "my-app"},
+			prompt:           &fakeActionTypePrompter{selectOneErr: errors.New("boom")},
+		}
+		require.Error(t, o.Ask())
+	})
+}
+
+type fakeCustomActionRegistrar struct {
+	created   *codepipeline.CustomActionTypeInput
+	createErr error
+}
+
+func (f *fakeCustomActionRegistrar) CreateCustomActionType(in *codepipeline.CustomActionTypeInput) error {
+	f.created = in
+	return f.createErr
+}
+
+func (f *fakeCustomActionRegistrar) DeleteCustomActionType(category, provider, version string) error {
+	return nil
+}
+
+func TestPipelineInitOpts_Execute(t *testing.T) {
+	t.Run("no-op when no custom action was chosen", func(t *testing.T) {
+		ws := &fakePipelineManifestReadWriter{existing: []byte("stages:\n  - name: build\n")}
+		registrar := &fakeCustomActionRegistrar{}
+		o := &pipelineInitOpts{ws: ws, registrar: registrar}
+
+		require.NoError(t, o.Execute())
+		require.Nil(t, ws.written)
+		require.Nil(t, registrar.created)
+	})
+
+	t.Run("registers the custom action type and appends to an existing manifest instead of clobbering it", func(t *testing.T) {
+		ws := &fakePipelineManifestReadWriter{existing: []byte("stages:\n  - name: build\n")}
+		registrar := &fakeCustomActionRegistrar{}
+		o := &pipelineInitOpts{
+			ws:           ws,
+			registrar:    registrar,
+			customAction: &manifest.CustomAction{Category: "Deploy", Provider: "JenkinsJob", Version: "1"},
+		}
+
+		require.NoError(t, o.Execute())
+		require.Equal(t, &codepipeline.CustomActionTypeInput{Category: "Deploy", Provider: "JenkinsJob", Version: "1"}, registrar.created)
+		require.Contains(t, string(ws.written), "name: build")
+		require.Contains(t, string(ws.written), "custom_action")
+		require.Contains(t, string(ws.written), "provider: JenkinsJob")
+	})
+
+	t.Run("preserves unrelated top-level manifest keys", func(t *testing.T) {
+		ws := &fakePipelineManifestReadWriter{existing: []byte("name: my-pipeline\nsource:\n  provider: GitHub\nstages:\n  - name: build\n")}
+		o := &pipelineInitOpts{
+			ws:           ws,
+			registrar:    &fakeCustomActionRegistrar{},
+			customAction: &manifest.CustomAction{Category: "Deploy", Provider: "JenkinsJob", Version: "1"},
+		}
+
+		require.NoError(t, o.Execute())
+		require.Contains(t, string(ws.written), "name: my-pipeline")
+		require.Contains(t, string(ws.written), "provider: GitHub")
+	})
+
+	t.Run("propagates a registration error", func(t *testing.T) {
+		ws := &fakePipelineManifestReadWriter{existing: []byte("stages:\n  - name: build\n")}
+		o := &pipelineInitOpts{
+			ws:           ws,
+			registrar:    &fakeCustomActionRegistrar{createErr: errors.New("boom")},
+			customAction: &manifest.CustomAction{Category: "Deploy", Provider: "JenkinsJob", Version: "1"},
+		}
+
+		require.Error(t, o.Execute())
+		require.Nil(t, ws.written)
+	})
+}
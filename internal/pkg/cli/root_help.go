@@ -0,0 +1,75 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// categoryHelpColumnWidth is how wide the first column of a category's two-column command list
+// is padded to before the second command on the row starts.
+const categoryHelpColumnWidth = 28
+
+// SetCatalogHelpFunc installs a help renderer on the root command that groups its subcommands by
+// category (two columns per row) instead of cobra's default flat, alphabetical list. Call this
+// once on the root command after every subcommand has registered with defaultCatalog.
+func SetCatalogHelpFunc(root *cobra.Command) {
+	// Snapshot cobra's own default help func before overriding it below. Once root.SetHelpFunc
+	// runs, HelpFunc() on *any* descendant resolves back to our override (cobra walks up to the
+	// nearest ancestor with a helpFunc set, and root is that ancestor for the whole tree), so
+	// fetching it again from inside the closure would just call ourselves forever. Grabbing it now
+	// is the only way to get a real fallback for non-root commands.
+	defaultHelpFunc := root.HelpFunc()
+
+	root.SetHelpFunc(func(cmd *cobra.Command, args []string) {
+		// Any command other than the root falls back to cobra's own help: the catalog only makes
+		// sense as an overview of the whole CLI surface, not a single command's usage.
+		if cmd != root {
+			defaultHelpFunc(cmd, args)
+			return
+		}
+		fmt.Fprint(cmd.OutOrStdout(), renderCatalogHelp(defaultCatalog, cmd))
+	})
+}
+
+// renderCatalogHelp renders the root command's long description followed by every registered
+// command grouped under its category, two per row.
+func renderCatalogHelp(desc catalogDescriber, cmd *cobra.Command) string {
+	catalog, err := desc.Describe()
+	if err != nil || len(catalog.Categories) == 0 {
+		return cmd.UsageString()
+	}
+
+	byCategory := make(map[string][]CommandMetadata)
+	for _, meta := range catalog.Commands {
+		byCategory[meta.Category] = append(byCategory[meta.Category], meta)
+	}
+
+	var b strings.Builder
+	if cmd.Long != "" {
+		fmt.Fprintf(&b, "%s\n\n", cmd.Long)
+	}
+	fmt.Fprintf(&b, "Usage:\n  %s\n", cmd.UseLine())
+
+	for _, category := range catalog.Categories {
+		fmt.Fprintf(&b, "\n%s:\n", category)
+		metas := byCategory[category]
+		for i := 0; i < len(metas); i += 2 {
+			left := fmt.Sprintf("  %s", metas[i].ID)
+			if i+1 >= len(metas) {
+				fmt.Fprintln(&b, left)
+				continue
+			}
+			fmt.Fprintf(&b, "%-*s%s\n", categoryHelpColumnWidth, left, metas[i+1].ID)
+		}
+	}
+
+	if cmd.HasAvailableLocalFlags() {
+		fmt.Fprintf(&b, "\nFlags:\n%s", cmd.LocalFlags().FlagUsages())
+	}
+	return b.String()
+}
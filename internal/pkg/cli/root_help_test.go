@@ -0,0 +1,71 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderCatalogHelp(t *testing.T) {
+	t.Run("groups commands by category, two per row", func(t *testing.T) {
+		c := newCatalog()
+		c.Register(CommandMetadata{ID: "app-init", Category: "Getting started"})
+		c.Register(CommandMetadata{ID: "env-init", Category: "Getting started"})
+		c.Register(CommandMetadata{ID: "svc-init", Category: "Getting started"})
+		c.Register(CommandMetadata{ID: "pipeline-init", Category: "Pipelines & CI/CD"})
+
+		root := &cobra.Command{Use: "copilot", Long: "The CLI for developing and operating ECS and App Runner applications."}
+		out := renderCatalogHelp(c, root)
+
+		require.Contains(t, out, "Getting started:")
+		require.Contains(t, out, "Pipelines & CI/CD:")
+		require.Contains(t, out, "app-init")
+		require.Contains(t, out, "svc-init")
+		require.Contains(t, out, "pipeline-init")
+		require.Contains(t, out, "  app-init                  env-init\n  svc-init\n")
+	})
+
+	t.Run("falls back to cobra's usage string when nothing is registered", func(t *testing.T) {
+		root := &cobra.Command{Use: "copilot"}
+		out := renderCatalogHelp(newCatalog(), root)
+		require.Equal(t, root.UsageString(), out)
+	})
+}
+
+func TestSetCatalogHelpFunc(t *testing.T) {
+	t.Run("a subcommand's help falls back to cobra's own help instead of recursing", func(t *testing.T) {
+		root := &cobra.Command{Use: "copilot"}
+		child := &cobra.Command{Use: "svc", Run: func(cmd *cobra.Command, args []string) {}}
+		root.AddCommand(child)
+		SetCatalogHelpFunc(root)
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			child.HelpFunc()(child, nil)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("SetCatalogHelpFunc recursed instead of falling back to cobra's default help")
+		}
+	})
+
+	t.Run("root's own help still renders through renderCatalogHelp", func(t *testing.T) {
+		root := &cobra.Command{Use: "copilot"}
+		SetCatalogHelpFunc(root)
+
+		var buf bytes.Buffer
+		root.SetOut(&buf)
+		root.HelpFunc()(root, nil)
+
+		require.Contains(t, buf.String(), "Usage:")
+	})
+}
@@ -0,0 +1,153 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/spf13/cobra"
+)
+
+const (
+	secretInitNameFlag              = "name"
+	secretInitValueFlag             = "value"
+	secretInitRotateFlag            = "rotate"
+	secretInitRotationLambdaARNFlag = "rotation-lambda-arn"
+	secretInitRotationDaysFlag      = "rotation-days"
+
+	defaultSecretRotationDays = 30
+)
+
+type secretInitVars struct {
+	name              string
+	value             string
+	rotate            bool
+	rotationLambdaARN string
+	rotationDays      int64
+}
+
+// secretInitOpts holds the options and dependencies needed to create, patch, or rotate a secret.
+type secretInitOpts struct {
+	secretInitVars
+
+	secretPutter secretsManager
+}
+
+func newSecretInitOpts(vars secretInitVars) (*secretInitOpts, error) {
+	sess, err := session.NewSession()
+	if err != nil {
+		return nil, fmt.Errorf("create session: %w", err)
+	}
+	return &secretInitOpts{
+		secretInitVars: vars,
+		secretPutter:   secretsmanager.New(sess),
+	}, nil
+}
+
+// Validate returns an error if the values provided by flags are invalid.
+func (o *secretInitOpts) Validate() error {
+	if o.name == "" {
+		return fmt.Errorf("--%s is required", secretInitNameFlag)
+	}
+	if o.value == "" {
+		return fmt.Errorf("--%s is required", secretInitValueFlag)
+	}
+	if o.rotate && o.rotationLambdaARN == "" {
+		return fmt.Errorf("--%s requires --%s", secretInitRotateFlag, secretInitRotationLambdaARNFlag)
+	}
+	if o.rotationDays <= 0 {
+		o.rotationDays = defaultSecretRotationDays
+	}
+	return nil
+}
+
+// Ask is a no-op: every input secret init needs is supplied through flags.
+func (o *secretInitOpts) Ask() error {
+	return nil
+}
+
+// Execute creates the secret if it doesn't exist yet, otherwise patches it with a new version.
+// When --rotate is set it additionally wires up automatic rotation via the provided Lambda ARN.
+func (o *secretInitOpts) Execute() error {
+	exists, err := o.secretPutter.DescribeSecret(o.name)
+	if err != nil {
+		return fmt.Errorf("describe secret %s: %w", o.name, err)
+	}
+
+	if exists {
+		if _, err := o.secretPutter.UpdateSecret(o.name, o.value); err != nil {
+			return fmt.Errorf("update secret %s: %w", o.name, err)
+		}
+	} else if _, err := o.secretPutter.CreateSecret(o.name, o.value); err != nil {
+		return fmt.Errorf("create secret %s: %w", o.name, err)
+	}
+
+	if o.rotate {
+		if err := o.secretPutter.RotateSecret(o.name, o.rotationLambdaARN, o.rotationDays); err != nil {
+			return fmt.Errorf("rotate secret %s: %w", o.name, err)
+		}
+	}
+	return nil
+}
+
+// RecommendedActions returns follow-up suggestions for the user.
+func (o *secretInitOpts) RecommendedActions() []string {
+	if !o.rotate {
+		return nil
+	}
+	return []string{
+		fmt.Sprintf("Rotation is enabled for %q every %d day(s).", o.name, o.rotationDays),
+	}
+}
+
+// Metadata returns the command's catalog entry.
+func (o *secretInitOpts) Metadata() CommandMetadata {
+	return CommandMetadata{
+		ID:       "secret-init",
+		Category: "Secrets",
+	}
+}
+
+func buildSecretInitCmd() *cobra.Command {
+	defaultCatalog.Register((&secretInitOpts{}).Metadata())
+
+	vars := secretInitVars{}
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "Create, patch, or rotate a secret in Secrets Manager.",
+		Example: `
+  Create (or patch, if it already exists) a secret named "db-password".
+  /code $ copilot secret init --name db-password --value "correct-horse-battery-staple"
+
+  Create a secret and enable rotation every 14 days via a Lambda function.
+  /code $ copilot secret init --name db-password --value "..." --rotate --rotation-lambda-arn arn:aws:lambda:... --rotation-days 14`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := newSecretInitOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			if err := opts.Execute(); err != nil {
+				return err
+			}
+			for _, action := range opts.RecommendedActions() {
+				fmt.Println(action)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&vars.name, secretInitNameFlag, "", "Name of the secret.")
+	cmd.Flags().StringVar(&vars.value, secretInitValueFlag, "", "Value of the secret.")
+	cmd.Flags().BoolVar(&vars.rotate, secretInitRotateFlag, false, "Enable automatic rotation for the secret.")
+	cmd.Flags().StringVar(&vars.rotationLambdaARN, secretInitRotationLambdaARNFlag, "", "ARN of the Lambda function that performs rotation.")
+	cmd.Flags().Int64Var(&vars.rotationDays, secretInitRotationDaysFlag, defaultSecretRotationDays, "Number of days between automatic rotations.")
+	return cmd
+}
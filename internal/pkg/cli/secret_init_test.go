@@ -0,0 +1,148 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSecretsManager struct {
+	exists bool
+
+	createCalled bool
+	updateCalled bool
+	rotateCalled bool
+
+	rotationLambdaARN string
+	rotationDays      int64
+
+	describeErr error
+	createErr   error
+	updateErr   error
+	rotateErr   error
+}
+
+func (f *fakeSecretsManager) CreateSecret(secretName, secretString string) (string, error) {
+	f.createCalled = true
+	return "arn:created", f.createErr
+}
+
+func (f *fakeSecretsManager) UpdateSecret(secretName, secretString string) (string, error) {
+	f.updateCalled = true
+	return "arn:updated", f.updateErr
+}
+
+func (f *fakeSecretsManager) DeleteSecret(secretName string) error {
+	return nil
+}
+
+func (f *fakeSecretsManager) RotateSecret(secretName, rotationLambdaARN string, days int64) error {
+	f.rotateCalled = true
+	f.rotationLambdaARN = rotationLambdaARN
+	f.rotationDays = days
+	return f.rotateErr
+}
+
+func (f *fakeSecretsManager) DescribeSecret(secretName string) (bool, error) {
+	return f.exists, f.describeErr
+}
+
+func TestSecretInitOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		vars    secretInitVars
+		wantErr string
+	}{
+		"missing name": {
+			vars:    secretInitVars{value: "v"},
+			wantErr: "--name is required",
+		},
+		"missing value": {
+			vars:    secretInitVars{name: "n"},
+			wantErr: "--value is required",
+		},
+		"rotate without lambda arn": {
+			vars:    secretInitVars{name: "n", value: "v", rotate: true},
+			wantErr: "--rotate requires --rotation-lambda-arn",
+		},
+		"valid": {
+			vars: secretInitVars{name: "n", value: "v"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &secretInitOpts{secretInitVars: tc.vars}
+			err := opts.Validate()
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestSecretInitOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		secretExists bool
+		rotate       bool
+		wantCreate   bool
+		wantUpdate   bool
+		wantRotate   bool
+	}{
+		"creates a new secret": {
+			secretExists: false,
+			wantCreate:   true,
+		},
+		"patches an existing secret instead of failing": {
+			secretExists: true,
+			wantUpdate:   true,
+		},
+		"rotates after creating when requested": {
+			secretExists: false,
+			rotate:       true,
+			wantCreate:   true,
+			wantRotate:   true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			fake := &fakeSecretsManager{exists: tc.secretExists}
+			opts := &secretInitOpts{
+				secretInitVars: secretInitVars{
+					name:              "db-password",
+					value:             "super-secret",
+					rotate:            tc.rotate,
+					rotationLambdaARN: "arn:aws:lambda:us-west-2:1234:function:rotate",
+					rotationDays:      14,
+				},
+				secretPutter: fake,
+			}
+
+			err := opts.Execute()
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantCreate, fake.createCalled)
+			require.Equal(t, tc.wantUpdate, fake.updateCalled)
+			require.Equal(t, tc.wantRotate, fake.rotateCalled)
+			if tc.wantRotate {
+				require.Equal(t, int64(14), fake.rotationDays)
+			}
+		})
+	}
+}
+
+func TestSecretInitOpts_Execute_DescribeError(t *testing.T) {
+	fake := &fakeSecretsManager{describeErr: errors.New("throttled")}
+	opts := &secretInitOpts{
+		secretInitVars: secretInitVars{name: "n", value: "v"},
+		secretPutter:   fake,
+	}
+	err := opts.Execute()
+	require.Error(t, err)
+	require.False(t, fake.createCalled)
+	require.False(t, fake.updateCalled)
+}
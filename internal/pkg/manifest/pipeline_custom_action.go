@@ -0,0 +1,22 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+// CustomAction describes a stage in a pipeline manifest that invokes a user-defined CodePipeline
+// action type instead of one of the built-in providers (GitHub, CodeCommit, CodeBuild,
+// CloudFormation).
+type CustomAction struct {
+	Category        string            `yaml:"category"`
+	Provider        string            `yaml:"provider"`
+	Version         string            `yaml:"version"`
+	Configuration   map[string]string `yaml:"configuration,omitempty"`
+	InputArtifacts  int               `yaml:"input_artifacts,omitempty"`
+	OutputArtifacts int               `yaml:"output_artifacts,omitempty"`
+}
+
+// IsCustom reports whether a pipeline stage is backed by a user-defined custom action type
+// rather than one of Copilot's built-in providers.
+func (a *CustomAction) IsCustom() bool {
+	return a != nil && a.Provider != ""
+}